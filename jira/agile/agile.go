@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"time"
 )
 
 type Client struct {
@@ -25,6 +26,16 @@ type Client struct {
 	Epic    *EpicService
 	BoardV2 agile.Board
 	EpicV2  agile.Epic
+
+	// RetryPolicy controls how call retries requests that fail with a
+	// transient error (429/5xx). A nil RetryPolicy disables retries, which
+	// keeps New's default behavior unchanged.
+	RetryPolicy *RetryPolicy
+
+	// Cache, when set, turns GET requests into conditional requests
+	// (If-None-Match/If-Modified-Since) and hydrates the caller's structure
+	// from the cached body on a 304. A nil Cache disables the feature.
+	Cache ResponseCache
 }
 
 func New(httpClient *http.Client, site string) (client *Client, err error) {
@@ -78,8 +89,8 @@ func (c *Client) newRequest(ctx context.Context, method, apiEndpoint string, pay
 		return nil, fmt.Errorf(requestCreationError, err.Error())
 	}
 
-	if c.Auth.basicAuthProvided {
-		request.SetBasicAuth(c.Auth.mail, c.Auth.token)
+	if err = c.Auth.apply(request); err != nil {
+		return nil, err
 	}
 
 	if c.Auth.userAgentProvided {
@@ -91,16 +102,78 @@ func (c *Client) newRequest(ctx context.Context, method, apiEndpoint string, pay
 
 func (c *Client) call(request *http.Request, structure interface{}) (result *models.ResponseScheme, err error) {
 
-	response, err := c.HTTP.Do(request)
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = noRetryPolicy
+	}
+
+	// Computed once from the pre-redirect request: request.URL and
+	// response.Request.URL can differ when the HTTP client follows a
+	// redirect, so recomputing the key from the response would silently
+	// miss the entry this same call just looked up. Left empty - disabling
+	// the cache for this request - when the configured authenticator can't
+	// tell one principal apart from another (e.g. OAuth2 with no Principal
+	// set), so distinct callers never collapse onto one shared entry.
+	var cacheEntryKey string
+	if c.Cache != nil && request.Method == http.MethodGet {
+		if principal, ok := c.Auth.principal(); ok {
+			cacheEntryKey = cacheKey(request.Method, request.URL.String(), principal)
+			if cached, ok := c.Cache.Get(cacheEntryKey); ok {
+				if cached.ETag != "" {
+					request.Header.Set("If-None-Match", cached.ETag)
+				}
+				if cached.LastModified != "" {
+					request.Header.Set("If-Modified-Since", cached.LastModified)
+				}
+			}
+		}
+	}
+
+	var response *http.Response
+
+attempts:
+	for attempt := 0; ; attempt++ {
+
+		if attempt > 0 && request.GetBody != nil {
+			var body io.ReadCloser
+			if body, err = request.GetBody(); err != nil {
+				return nil, err
+			}
+			request.Body = body
+		}
+
+		response, err = c.HTTP.Do(request)
+
+		if !policy.shouldRetry(attempt, response, err) {
+			break
+		}
+
+		// This response triggered a retry and won't be handed to
+		// transformTheHTTPResponse, so it has to be drained and closed here
+		// or the underlying connection leaks.
+		if response != nil {
+			_, _ = io.Copy(ioutil.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+
+		select {
+		case <-request.Context().Done():
+			if err == nil {
+				err = request.Context().Err()
+			}
+			break attempts
+		case <-time.After(policy.nextDelay(attempt, response)):
+		}
+	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	return c.transformTheHTTPResponse(response, structure)
+	return c.transformTheHTTPResponse(response, structure, cacheEntryKey)
 }
 
-func (c *Client) transformTheHTTPResponse(response *http.Response, structure interface{}) (result *models.ResponseScheme, err error) {
+func (c *Client) transformTheHTTPResponse(response *http.Response, structure interface{}, cacheEntryKey string) (result *models.ResponseScheme, err error) {
 
 	if response == nil {
 		return nil, errors.New("validation failed, please provide a http.Response pointer")
@@ -111,17 +184,46 @@ func (c *Client) transformTheHTTPResponse(response *http.Response, structure int
 	responseTransformed.Endpoint = response.Request.URL.String()
 	responseTransformed.Method = response.Request.Method
 
-	var wasSuccess = response.StatusCode >= 200 && response.StatusCode < 300
-	if !wasSuccess {
-
-		return responseTransformed, fmt.Errorf(requestFailedError, response.StatusCode)
-	}
-
 	responseAsBytes, err := ioutil.ReadAll(response.Body)
 	if err != nil {
 		return responseTransformed, err
 	}
 
+	if response.StatusCode == http.StatusAccepted {
+		responseTransformed.Bytes.Write(responseAsBytes)
+		return responseTransformed, &AsyncAccepted{Location: response.Header.Get("Location")}
+	}
+
+	if response.StatusCode == http.StatusNotModified {
+		if cacheEntryKey != "" {
+			if cached, ok := c.Cache.Get(cacheEntryKey); ok {
+				responseTransformed.Code = http.StatusOK
+				responseTransformed.Bytes.Write(cached.Body)
+
+				if structure != nil {
+					if err = json.Unmarshal(cached.Body, &structure); err != nil {
+						return responseTransformed, err
+					}
+				}
+
+				return responseTransformed, nil
+			}
+		}
+
+		// The server validated our If-None-Match/If-Modified-Since, but the
+		// entry it validated against is no longer in the cache (e.g.
+		// evicted under memory pressure) - there's nothing to hydrate
+		// structure from, so surface that instead of a misleading AgileError
+		// built from an empty 304 body.
+		return responseTransformed, fmt.Errorf("agile: got 304 Not Modified for %s %s but no cached response was found", responseTransformed.Method, responseTransformed.Endpoint)
+	}
+
+	var wasSuccess = response.StatusCode >= 200 && response.StatusCode < 300
+	if !wasSuccess {
+		responseTransformed.Bytes.Write(responseAsBytes)
+		return responseTransformed, models.NewAgileError(response.StatusCode, responseTransformed.Method, responseTransformed.Endpoint, responseAsBytes)
+	}
+
 	if structure != nil {
 		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
 			return responseTransformed, err
@@ -130,9 +232,23 @@ func (c *Client) transformTheHTTPResponse(response *http.Response, structure int
 
 	responseTransformed.Bytes.Write(responseAsBytes)
 
+	if cacheEntryKey != "" {
+		if etag := response.Header.Get("ETag"); etag != "" || response.Header.Get("Last-Modified") != "" {
+			c.Cache.Set(cacheEntryKey, CachedResponse{
+				ETag:         etag,
+				LastModified: response.Header.Get("Last-Modified"),
+				Body:         responseAsBytes,
+			})
+		}
+	}
+
 	return responseTransformed, nil
 }
 
+// transformStructToReader marshals structure once and hands back a
+// *bytes.Reader over the resulting bytes. http.NewRequestWithContext
+// recognizes that concrete type and wires up Request.GetBody from it, so
+// call can rewind the body and replay the request across retry attempts.
 func (c *Client) transformStructToReader(structure interface{}) (reader io.Reader, err error) {
 
 	if structure == nil || reflect.ValueOf(structure).IsNil() {
@@ -0,0 +1,163 @@
+package agile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// AsyncAccepted is returned when a request comes back 202 Accepted: the
+// operation hasn't finished yet, so decoding the body into the caller's
+// structure would be misleading. Callers that need the result should use
+// the corresponding *Async method (e.g. Sprint.MoveIssuesAsync) instead of
+// the blocking one.
+type AsyncAccepted struct {
+	Location string
+}
+
+func (e *AsyncAccepted) Error() string {
+	return fmt.Sprintf("agile: request accepted, operation is running asynchronously at %s", e.Location)
+}
+
+// asyncTaskStatus mirrors the subset of the Jira Agile task-status resource
+// that pollForAsyncResponse needs to decide whether an operation is done.
+type asyncTaskStatus struct {
+	Status string `json:"status"`
+}
+
+// terminal states reported by the task-status resource.
+const (
+	asyncStatusComplete = "COMPLETE"
+	asyncStatusFailed   = "FAILED"
+	asyncStatusDead     = "DEAD"
+)
+
+// pollForAsyncResponse polls the task-status resource at location, honouring
+// Retry-After between polls, until the task reaches a terminal state or ctx
+// is done. The terminal response (whatever its status code) is decoded
+// through transformTheHTTPResponse, same as every other call in the package,
+// so Future callers get a *models.ResponseScheme/AgileError instead of a raw
+// *http.Response they'd have to decode themselves.
+func (c *Client) pollForAsyncResponse(ctx context.Context, location string) (*models.ResponseScheme, error) {
+
+	if location == "" {
+		return nil, fmt.Errorf("agile: cannot poll an async operation without a Location header")
+	}
+
+	for {
+
+		request, err := c.newRequest(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := c.HTTP.Do(request)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusAccepted {
+			return c.transformTheHTTPResponse(response, nil, "")
+		}
+
+		bodyBytes, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+		_ = response.Body.Close()
+		response.Body = ioutil.NopCloser(bytes.NewReader(bodyBytes))
+
+		var status asyncTaskStatus
+		if err = json.Unmarshal(bodyBytes, &status); err != nil {
+			return nil, err
+		}
+
+		switch status.Status {
+		case asyncStatusComplete, asyncStatusFailed, asyncStatusDead:
+			return c.transformTheHTTPResponse(response, nil, "")
+		}
+
+		delay := 1 * time.Second
+		if d, ok := retryAfterDelay(response); ok {
+			delay = d
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// Future represents an Agile operation that was accepted (202) and is still
+// running on the server, such as a sprint issue move or a board create.
+type Future struct {
+	done   chan struct{}
+	result *models.ResponseScheme
+	err    error
+	cancel context.CancelFunc
+}
+
+// newFuture starts polling the task-status resource at location in the
+// background and returns a handle the caller can Wait on, Poll, or Cancel.
+//
+// The background poll deliberately doesn't inherit the kickoff request's
+// context: callers routinely write
+// `ctx, cancel := context.WithTimeout(parent, N); defer cancel()` around the
+// kickoff call, and that deferred cancel fires the moment the kickoff
+// returns. If the poll were tied to that ctx, it would die right there
+// instead of running until Future.Wait's own ctx says otherwise. The poll's
+// lifetime is governed solely by Future.Cancel and reaching a terminal
+// state.
+func newFuture(c *Client, location string) *Future {
+
+	pollCtx, cancel := context.WithCancel(context.Background())
+
+	f := &Future{
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+
+	go func() {
+		defer close(f.done)
+		f.result, f.err = c.pollForAsyncResponse(pollCtx, location)
+	}()
+
+	return f
+}
+
+// Wait blocks until the operation reaches a terminal state, ctx is done, or
+// Cancel is called.
+func (f *Future) Wait(ctx context.Context) (*models.ResponseScheme, error) {
+
+	select {
+	case <-f.done:
+		return f.result, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Poll reports whether the operation has reached a terminal state without
+// blocking. ok is true only once the result is available.
+func (f *Future) Poll() (response *models.ResponseScheme, ok bool) {
+
+	select {
+	case <-f.done:
+		return f.result, true
+	default:
+		return nil, false
+	}
+}
+
+// Cancel stops polling; a subsequent Wait returns ctx.Err() unless the
+// operation had already reached a terminal state.
+func (f *Future) Cancel() {
+	f.cancel()
+}
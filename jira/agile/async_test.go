@@ -0,0 +1,191 @@
+package agile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestPollForAsyncResponseCompletes drives pollForAsyncResponse against a
+// task-status resource that reports IN_PROGRESS twice before COMPLETE, and
+// asserts the Future-facing result is decoded through
+// transformTheHTTPResponse (a *models.ResponseScheme, not a raw
+// *http.Response left for the caller to read).
+func TestPollForAsyncResponseCompletes(t *testing.T) {
+
+	var polls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		n := atomic.AddInt32(&polls, 1)
+
+		status := "IN_PROGRESS"
+		if n >= 3 {
+			status = asyncStatusComplete
+		}
+
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"status":%q}`, status)
+	}))
+	defer server.Close()
+
+	client := &Client{HTTP: server.Client(), Auth: &AuthenticationService{}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	result, err := client.pollForAsyncResponse(ctx, server.URL)
+	if err != nil {
+		t.Fatalf("pollForAsyncResponse returned an error: %v", err)
+	}
+
+	if result.Code != http.StatusOK {
+		t.Fatalf("got status %d, want 200", result.Code)
+	}
+
+	if got := atomic.LoadInt32(&polls); got != 3 {
+		t.Fatalf("got %d polls, want 3 (2 IN_PROGRESS + 1 COMPLETE)", got)
+	}
+}
+
+// TestPollForAsyncResponseSurfacesTerminalFailure asserts a non-200/202
+// terminal response is decoded (and its error surfaced) rather than handed
+// back as an unread *http.Response.
+func TestPollForAsyncResponseSurfacesTerminalFailure(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"errorMessages":["task failed"]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{HTTP: server.Client(), Auth: &AuthenticationService{}}
+
+	_, err := client.pollForAsyncResponse(context.Background(), server.URL)
+	if err == nil {
+		t.Fatalf("expected a non-200/202 terminal response to surface an error")
+	}
+
+	var agileErr *models.AgileError
+	if !errors.As(err, &agileErr) {
+		t.Fatalf("expected the terminal response to decode into an error, got %T", err)
+	}
+}
+
+// TestPollForAsyncResponseStopsOnDone asserts a cancelled context stops the
+// poll loop instead of spinning forever.
+func TestPollForAsyncResponseStopsOnDone(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status":"IN_PROGRESS"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{HTTP: server.Client(), Auth: &AuthenticationService{}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.pollForAsyncResponse(ctx, server.URL)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got err %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestPollForAsyncResponseRequiresLocation(t *testing.T) {
+
+	client := &Client{HTTP: http.DefaultClient, Auth: &AuthenticationService{}}
+
+	if _, err := client.pollForAsyncResponse(context.Background(), ""); err == nil {
+		t.Fatalf("expected an empty location to be rejected")
+	}
+}
+
+// TestFutureWaitReturnsPollResult exercises the Future handle end-to-end:
+// newFuture polls in the background, and Wait blocks until it's done.
+func TestFutureWaitReturnsPollResult(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status":"COMPLETE"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{HTTP: server.Client(), Auth: &AuthenticationService{}}
+
+	future := newFuture(client, server.URL)
+
+	result, err := future.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait returned an error: %v", err)
+	}
+
+	if result.Code != http.StatusOK {
+		t.Fatalf("got status %d, want 200", result.Code)
+	}
+}
+
+// TestMoveIssuesAsyncPollSurvivesKickoffContextCancellation guards against
+// the poll's lifetime being tied to the kickoff request's context. Idiomatic
+// callers write `ctx, cancel := context.WithTimeout(parent, N); defer
+// cancel()` around the kickoff call, so cancel fires the instant
+// MoveIssuesAsync returns; the background poll must keep running and reach
+// the terminal state regardless.
+func TestMoveIssuesAsyncPollSurvivesKickoffContextCancellation(t *testing.T) {
+
+	var polls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method == http.MethodPost {
+			w.Header().Set("Location", fmt.Sprintf("http://%s/task/1", r.Host))
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		status := "IN_PROGRESS"
+		if atomic.AddInt32(&polls, 1) >= 2 {
+			status = asyncStatusComplete
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"status":%q}`, status)
+	}))
+	defer server.Close()
+
+	site, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse test server URL: %v", err)
+	}
+
+	client := &Client{Site: site, HTTP: server.Client(), Auth: &AuthenticationService{}}
+	sprintService := &SprintService{client: client}
+
+	kickoffCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+
+	future, err := sprintService.MoveIssuesAsync(kickoffCtx, 1, &models.SprintMovePayloadScheme{})
+	cancel()
+
+	if err != nil {
+		t.Fatalf("MoveIssuesAsync returned an error: %v", err)
+	}
+
+	result, err := future.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("expected the poll to survive cancellation of the kickoff ctx, got error: %v", err)
+	}
+
+	if result.Code != http.StatusOK {
+		t.Fatalf("got status %d, want 200", result.Code)
+	}
+}
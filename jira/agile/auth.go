@@ -0,0 +1,210 @@
+package agile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"golang.org/x/oauth2"
+	"net/http"
+	"strings"
+)
+
+// Authenticator applies credentials to an outgoing request. Client.newRequest
+// runs every configured Authenticator in order instead of special-casing a
+// single auth scheme, so Basic auth, bearer tokens, PATs and OAuth 2.0 (3LO)
+// can all be used interchangeably.
+type Authenticator interface {
+	Apply(request *http.Request) error
+}
+
+// AuthenticationService configures how the Client authenticates outgoing
+// requests and what User-Agent it sends.
+type AuthenticationService struct {
+	client *Client
+
+	authenticators []Authenticator
+
+	userAgentProvided bool
+	agent             string
+}
+
+// SetBasicAuth configures HTTP Basic authentication using a mail/token (or
+// mail/password on Data Center) pair. It installs a BasicAuth authenticator;
+// existing callers don't need to change anything.
+func (a *AuthenticationService) SetBasicAuth(mail, token string) {
+	a.use(&BasicAuth{Mail: mail, Token: token})
+}
+
+// SetBearerToken configures authentication via a static bearer token.
+func (a *AuthenticationService) SetBearerToken(token string) {
+	a.use(&BearerToken{Token: token})
+}
+
+// SetPersonalAccessToken configures authentication via a Jira Data Center
+// Personal Access Token, sent as a bearer token.
+func (a *AuthenticationService) SetPersonalAccessToken(token string) {
+	a.use(&PersonalAccessToken{Token: token})
+}
+
+// SetOAuth2 configures authentication via Jira Cloud's OAuth 2.0 (3LO) flow.
+// source is consulted on every request, so token refresh happens
+// transparently.
+func (a *AuthenticationService) SetOAuth2(source oauth2.TokenSource) {
+	a.use(&OAuth2{Source: source})
+}
+
+// SetUserAgent overrides the User-Agent header sent with every request.
+func (a *AuthenticationService) SetUserAgent(agent string) {
+	a.userAgentProvided = true
+	a.agent = agent
+}
+
+// use installs authenticator as the (sole) configured Authenticator,
+// replacing whatever a previous Set* call installed. Calling Auth.SetOAuth2
+// and later Auth.SetBasicAuth to override it for an environment must not
+// leave the stale OAuth2 authenticator running ahead of Basic auth in
+// apply.
+func (a *AuthenticationService) use(authenticator Authenticator) {
+	a.authenticators = []Authenticator{authenticator}
+}
+
+// apply runs every configured authenticator against request, in the order
+// they were set.
+func (a *AuthenticationService) apply(request *http.Request) error {
+
+	for _, authenticator := range a.authenticators {
+		if err := authenticator.Apply(request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// principalIdentifier is implemented by authenticators that can describe the
+// account they authenticate as, so the response cache can key entries per
+// principal instead of sharing them across every credential. ok is false
+// when the authenticator can't cheaply tell callers apart (e.g. an OAuth2
+// authenticator with no Principal set) - callers must not fall back to a
+// shared key in that case.
+type principalIdentifier interface {
+	principal() (id string, ok bool)
+}
+
+// principal identifies the account the client authenticates as, for use in
+// cache keys. It returns ok == false - meaning "don't cache" - whenever any
+// configured authenticator can't be distinguished from another instance
+// using the same scheme, so two different accounts never collapse onto one
+// shared cache entry. A Client with no authenticator configured is
+// considered a single anonymous principal.
+func (a *AuthenticationService) principal() (id string, ok bool) {
+
+	if len(a.authenticators) == 0 {
+		return "anonymous", true
+	}
+
+	ids := make([]string, 0, len(a.authenticators))
+	for _, authenticator := range a.authenticators {
+
+		identifiable, ok := authenticator.(principalIdentifier)
+		if !ok {
+			return "", false
+		}
+
+		authID, ok := identifiable.principal()
+		if !ok {
+			return "", false
+		}
+
+		ids = append(ids, authID)
+	}
+
+	return strings.Join(ids, "+"), true
+}
+
+// hashToken keeps raw credentials out of cache keys while still telling two
+// different tokens apart.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:8])
+}
+
+// BasicAuth authenticates with HTTP Basic auth.
+type BasicAuth struct {
+	Mail  string
+	Token string
+}
+
+func (b *BasicAuth) Apply(request *http.Request) error {
+	request.SetBasicAuth(b.Mail, b.Token)
+	return nil
+}
+
+func (b *BasicAuth) principal() (string, bool) {
+	return "basic:" + b.Mail, true
+}
+
+// BearerToken authenticates by sending a static bearer token.
+type BearerToken struct {
+	Token string
+}
+
+func (b *BearerToken) Apply(request *http.Request) error {
+	request.Header.Set("Authorization", "Bearer "+b.Token)
+	return nil
+}
+
+func (b *BearerToken) principal() (string, bool) {
+	return "bearer:" + hashToken(b.Token), true
+}
+
+// PersonalAccessToken authenticates with a Jira Data Center PAT, sent the
+// same way as a bearer token.
+type PersonalAccessToken struct {
+	Token string
+}
+
+func (p *PersonalAccessToken) Apply(request *http.Request) error {
+	request.Header.Set("Authorization", "Bearer "+p.Token)
+	return nil
+}
+
+func (p *PersonalAccessToken) principal() (string, bool) {
+	return "pat:" + hashToken(p.Token), true
+}
+
+// OAuth2 authenticates using Jira Cloud's OAuth 2.0 (3LO) flow. Source is
+// consulted on every request, so expired tokens are refreshed transparently.
+//
+// Principal is optional and only used as a cache key: deriving one from
+// Source would mean calling Source.Token() (and possibly triggering a
+// refresh) just to identify the caller. Set it to the account/tenant this
+// TokenSource authenticates as - e.g. the Atlassian account ID - to let the
+// response cache key entries per account. While Principal is empty, the
+// authenticator reports no principal and the Client bypasses the response
+// cache for every request it authenticates.
+type OAuth2 struct {
+	Source    oauth2.TokenSource
+	Principal string
+}
+
+func (o *OAuth2) principal() (string, bool) {
+
+	if o.Principal == "" {
+		return "", false
+	}
+
+	return "oauth2:" + hashToken(o.Principal), true
+}
+
+func (o *OAuth2) Apply(request *http.Request) error {
+
+	token, err := o.Source.Token()
+	if err != nil {
+		return fmt.Errorf("agile: failed to obtain OAuth2 token: %w", err)
+	}
+
+	token.SetAuthHeader(request)
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package agile
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func newTestRequest(t *testing.T) *http.Request {
+	t.Helper()
+
+	request, err := http.NewRequest(http.MethodGet, "https://example.atlassian.net/rest/agile/1.0/board/1", nil)
+	if err != nil {
+		t.Fatalf("failed to build test request: %v", err)
+	}
+
+	return request
+}
+
+func TestBasicAuthApplySetsBasicAuthHeader(t *testing.T) {
+
+	request := newTestRequest(t)
+
+	if err := (&BasicAuth{Mail: "a@example.com", Token: "secret"}).Apply(request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mail, token, ok := request.BasicAuth()
+	if !ok || mail != "a@example.com" || token != "secret" {
+		t.Fatalf("got (%q, %q, %v), want (a@example.com, secret, true)", mail, token, ok)
+	}
+}
+
+func TestBearerTokenApplySetsAuthorizationHeader(t *testing.T) {
+
+	request := newTestRequest(t)
+
+	if err := (&BearerToken{Token: "tok"}).Apply(request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := request.Header.Get("Authorization"), "Bearer tok"; got != want {
+		t.Fatalf("got Authorization %q, want %q", got, want)
+	}
+}
+
+func TestPersonalAccessTokenApplySetsAuthorizationHeader(t *testing.T) {
+
+	request := newTestRequest(t)
+
+	if err := (&PersonalAccessToken{Token: "pat"}).Apply(request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := request.Header.Get("Authorization"), "Bearer pat"; got != want {
+		t.Fatalf("got Authorization %q, want %q", got, want)
+	}
+}
+
+func TestOAuth2ApplySetsAuthHeaderFromSource(t *testing.T) {
+
+	request := newTestRequest(t)
+
+	source := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access-token", TokenType: "Bearer"})
+
+	if err := (&OAuth2{Source: source}).Apply(request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := request.Header.Get("Authorization"), "Bearer access-token"; got != want {
+		t.Fatalf("got Authorization %q, want %q", got, want)
+	}
+}
+
+// erroringTokenSource always fails, to exercise OAuth2.Apply's error path.
+type erroringTokenSource struct{}
+
+func (erroringTokenSource) Token() (*oauth2.Token, error) {
+	return nil, errors.New("refresh failed")
+}
+
+func TestOAuth2ApplyWrapsTokenSourceError(t *testing.T) {
+
+	request := newTestRequest(t)
+
+	err := (&OAuth2{Source: erroringTokenSource{}}).Apply(request)
+	if err == nil {
+		t.Fatalf("expected an error when the token source fails")
+	}
+
+	if got, want := err.Error(), "agile: failed to obtain OAuth2 token: refresh failed"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	if request.Header.Get("Authorization") != "" {
+		t.Fatalf("expected no Authorization header to be set when the token source fails")
+	}
+}
+
+func TestAuthenticationServiceApplyRunsConfiguredAuthenticator(t *testing.T) {
+
+	auth := &AuthenticationService{}
+	auth.SetBearerToken("tok")
+
+	request := newTestRequest(t)
+
+	if err := auth.apply(request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := request.Header.Get("Authorization"), "Bearer tok"; got != want {
+		t.Fatalf("got Authorization %q, want %q", got, want)
+	}
+}
+
+// TestAuthenticationServiceApplyReplacesPriorAuthenticator guards against a
+// later Set* call leaving a stale authenticator running ahead of the new
+// one - e.g. SetOAuth2 followed by SetBasicAuth must apply only Basic auth.
+func TestAuthenticationServiceApplyReplacesPriorAuthenticator(t *testing.T) {
+
+	auth := &AuthenticationService{}
+	auth.SetOAuth2(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "stale-token"}))
+	auth.SetBasicAuth("a@example.com", "secret")
+
+	request := newTestRequest(t)
+
+	if err := auth.apply(request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if request.Header.Get("Authorization") == "Bearer stale-token" {
+		t.Fatalf("expected no stale OAuth2 Authorization header after switching to Basic auth")
+	}
+
+	mail, token, ok := request.BasicAuth()
+	if !ok || mail != "a@example.com" || token != "secret" {
+		t.Fatalf("got (%q, %q, %v), want (a@example.com, secret, true)", mail, token, ok)
+	}
+}
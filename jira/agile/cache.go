@@ -0,0 +1,120 @@
+package agile
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+)
+
+// CachedResponse is what a ResponseCache stores for a single GET: the
+// validators Jira returned alongside the raw response body, so a later 304 can
+// be served from memory instead of failing.
+type CachedResponse struct {
+	ETag         string
+	LastModified string
+	Body         []byte
+}
+
+// ResponseCache lets Client turn GET requests into conditional requests.
+// Get/Set are keyed by cacheKey (method, endpoint and auth principal);
+// Invalidate drops an entry, e.g. after a write to the same resource.
+// Implementations must be safe for concurrent use.
+type ResponseCache interface {
+	Get(key string) (CachedResponse, bool)
+	Set(key string, response CachedResponse)
+	Invalidate(key string)
+}
+
+// cacheKey identifies a cached GET by method, endpoint and the principal the
+// request authenticates as, so two accounts reading the same board never
+// share a cache entry.
+func cacheKey(method, endpoint, principal string) string {
+	sum := sha256.Sum256([]byte(method + " " + endpoint + " " + principal))
+	return hex.EncodeToString(sum[:])
+}
+
+// WithCache configures cache as the Client's response cache and returns the
+// Client, so it can be chained off New.
+func (c *Client) WithCache(cache ResponseCache) *Client {
+	c.Cache = cache
+	return c
+}
+
+// lruCache is the default in-memory ResponseCache: a fixed-capacity,
+// least-recently-used map guarded by a mutex.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type lruEntry struct {
+	key      string
+	response CachedResponse
+}
+
+// NewInMemoryCache returns a ResponseCache that keeps at most capacity
+// entries, evicting the least-recently-used one once it's full. capacity
+// <= 0 defaults to 128.
+func NewInMemoryCache(capacity int) ResponseCache {
+
+	if capacity <= 0 {
+		capacity = 128
+	}
+
+	return &lruCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (l *lruCache) Get(key string) (CachedResponse, bool) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.items[key]
+	if !ok {
+		return CachedResponse{}, false
+	}
+
+	l.order.MoveToFront(element)
+	return element.Value.(*lruEntry).response, true
+}
+
+func (l *lruCache) Set(key string, response CachedResponse) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.items[key]; ok {
+		element.Value.(*lruEntry).response = response
+		l.order.MoveToFront(element)
+		return
+	}
+
+	element := l.order.PushFront(&lruEntry{key: key, response: response})
+	l.items[key] = element
+
+	if l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		if oldest != nil {
+			l.order.Remove(oldest)
+			delete(l.items, oldest.Value.(*lruEntry).key)
+		}
+	}
+}
+
+func (l *lruCache) Invalidate(key string) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.items[key]; ok {
+		l.order.Remove(element)
+		delete(l.items, key)
+	}
+}
@@ -0,0 +1,175 @@
+package agile
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestLRUCacheGetSetInvalidate(t *testing.T) {
+
+	cache := NewInMemoryCache(2)
+
+	if _, ok := cache.Get("missing"); ok {
+		t.Fatalf("expected a miss on an empty cache")
+	}
+
+	cache.Set("a", CachedResponse{ETag: "etag-a", Body: []byte("a")})
+
+	cached, ok := cache.Get("a")
+	if !ok || cached.ETag != "etag-a" {
+		t.Fatalf("got %+v, %v; want a hit with ETag etag-a", cached, ok)
+	}
+
+	cache.Invalidate("a")
+	if _, ok = cache.Get("a"); ok {
+		t.Fatalf("expected Invalidate to remove the entry")
+	}
+}
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+
+	cache := NewInMemoryCache(2)
+
+	cache.Set("a", CachedResponse{Body: []byte("a")})
+	cache.Set("b", CachedResponse{Body: []byte("b")})
+
+	// Touch "a" so "b" becomes the least recently used entry.
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatalf("expected a to be present before eviction")
+	}
+
+	cache.Set("c", CachedResponse{Body: []byte("c")})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Fatalf("expected b to have been evicted as the least recently used entry")
+	}
+
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatalf("expected a to survive eviction since it was touched more recently")
+	}
+
+	if _, ok := cache.Get("c"); !ok {
+		t.Fatalf("expected c to be present as the most recently inserted entry")
+	}
+}
+
+// TestClientCallStoresAndHitsCache drives a real GET through Client.call
+// against a server that returns an ETag, then a 304 once that ETag comes
+// back as If-None-Match, asserting the second call hydrates structure from
+// the cache instead of erroring out on the 304.
+func TestClientCallStoresAndHitsCache(t *testing.T) {
+
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		atomic.AddInt32(&requests, 1)
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"name":"board-1"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTP:  server.Client(),
+		Auth:  &AuthenticationService{},
+		Cache: NewInMemoryCache(8),
+	}
+
+	type board struct {
+		Name string `json:"name"`
+	}
+
+	first := &board{}
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("building request: %v", err)
+	}
+	if _, err = client.call(request, first); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	if first.Name != "board-1" {
+		t.Fatalf("got %+v, want board-1", first)
+	}
+
+	second := &board{}
+	request, err = http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("building request: %v", err)
+	}
+	if _, err = client.call(request, second); err != nil {
+		t.Fatalf("second call (expected a 304 cache hit): %v", err)
+	}
+	if second.Name != "board-1" {
+		t.Fatalf("got %+v, want the cached board hydrated from the 304", second)
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Fatalf("got %d requests, want 2 (one MISS, one 304)", got)
+	}
+}
+
+func TestAuthenticationServicePrincipalAnonymous(t *testing.T) {
+
+	auth := &AuthenticationService{}
+
+	id, ok := auth.principal()
+	if !ok || id != "anonymous" {
+		t.Fatalf("got (%q, %v), want (anonymous, true)", id, ok)
+	}
+}
+
+func TestAuthenticationServicePrincipalBasicAuth(t *testing.T) {
+
+	auth := &AuthenticationService{}
+	auth.SetBasicAuth("a@example.com", "token")
+
+	id, ok := auth.principal()
+	if !ok || id != "basic:a@example.com" {
+		t.Fatalf("got (%q, %v), want (basic:a@example.com, true)", id, ok)
+	}
+}
+
+// TestAuthenticationServicePrincipalOAuth2WithoutPrincipalRefusesToCache
+// guards against distinct OAuth2-authenticated callers silently sharing one
+// "anonymous" cache entry on a shared ResponseCache backend.
+func TestAuthenticationServicePrincipalOAuth2WithoutPrincipalRefusesToCache(t *testing.T) {
+
+	auth := &AuthenticationService{}
+	auth.SetOAuth2(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}))
+
+	if _, ok := auth.principal(); ok {
+		t.Fatalf("expected an OAuth2 authenticator with no Principal set to refuse to produce a cache principal")
+	}
+}
+
+func TestAuthenticationServicePrincipalOAuth2WithPrincipalIsDistinguishable(t *testing.T) {
+
+	authA := &AuthenticationService{}
+	authA.use(&OAuth2{Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}), Principal: "tenant-a"})
+
+	authB := &AuthenticationService{}
+	authB.use(&OAuth2{Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}), Principal: "tenant-b"})
+
+	idA, okA := authA.principal()
+	idB, okB := authB.principal()
+
+	if !okA || !okB {
+		t.Fatalf("expected both tenants to produce a cacheable principal")
+	}
+
+	if idA == idB {
+		t.Fatalf("expected distinct OAuth2 principals to produce distinct cache keys, got %q for both", idA)
+	}
+}
@@ -0,0 +1,126 @@
+package agile
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// issuePageScheme is the startAt/maxResults/isLast envelope every Agile
+// board/sprint/epic issue-list endpoint wraps its issues in.
+type issuePageScheme struct {
+	Issues     []*models.IssueScheme `json:"issues"`
+	StartAt    int                   `json:"startAt"`
+	MaxResults int                   `json:"maxResults"`
+	Total      int                   `json:"total"`
+	IsLast     bool                  `json:"isLast"`
+}
+
+// IssueListPager builds an Iterator over an issue-list endpoint, fetching
+// additional pages with startAt/maxResults as the caller advances instead of
+// requiring one manual request per page. Construct one via
+// BoardService.Issues, SprintService.Issues or EpicService.Issues rather
+// than directly.
+type IssueListPager struct {
+	client     *Client
+	endpoint   string
+	opts       *models.IssueOptionScheme
+	maxResults int
+}
+
+// Issues returns a pager over every issue on the board. opts may be nil;
+// maxResults <= 0 uses the server's default page size.
+//
+// https://docs.atlassian.com/jira-software/REST/latest/#agile/1.0/board-getIssuesForBoard
+func (s *BoardService) Issues(boardID int, opts *models.IssueOptionScheme, maxResults int) *IssueListPager {
+
+	return &IssueListPager{
+		client:     s.c,
+		endpoint:   fmt.Sprintf("rest/agile/%v/board/%v/issue", s.version, boardID),
+		opts:       opts,
+		maxResults: maxResults,
+	}
+}
+
+// Issues returns a pager over every issue on the sprint. opts may be nil;
+// maxResults <= 0 uses the server's default page size.
+//
+// https://docs.atlassian.com/jira-software/REST/latest/#agile/1.0/sprint-getIssuesForSprint
+func (s *SprintService) Issues(sprintID int, opts *models.IssueOptionScheme, maxResults int) *IssueListPager {
+
+	return &IssueListPager{
+		client:     s.client,
+		endpoint:   fmt.Sprintf("rest/agile/1.0/sprint/%v/issue", sprintID),
+		opts:       opts,
+		maxResults: maxResults,
+	}
+}
+
+// Issues returns a pager over every issue on the epic. opts may be nil;
+// maxResults <= 0 uses the server's default page size.
+//
+// https://docs.atlassian.com/jira-software/REST/latest/#agile/1.0/epic-getIssuesForEpic
+func (s *EpicService) Issues(epicID int, opts *models.IssueOptionScheme, maxResults int) *IssueListPager {
+
+	return &IssueListPager{
+		client:     s.c,
+		endpoint:   fmt.Sprintf("rest/agile/%v/epic/%v/issue", s.version, epicID),
+		opts:       opts,
+		maxResults: maxResults,
+	}
+}
+
+// Iter returns an Iterator that fetches pages lazily as the caller advances
+// through Next/Value, stopping once the server reports isLast.
+func (p *IssueListPager) Iter() *Iterator[*models.IssueScheme] {
+	return NewIterator(p.fetchPage)
+}
+
+// IterChan is a convenience wrapper around Iter().IterChan for
+// pipeline-style consumption.
+func (p *IssueListPager) IterChan(ctx context.Context) <-chan Result[*models.IssueScheme] {
+	return p.Iter().IterChan(ctx)
+}
+
+func (p *IssueListPager) fetchPage(ctx context.Context, startAt int) ([]*models.IssueScheme, *PageMeta, error) {
+
+	query := url.Values{}
+	query.Set("startAt", fmt.Sprintf("%d", startAt))
+	if p.maxResults > 0 {
+		query.Set("maxResults", fmt.Sprintf("%d", p.maxResults))
+	}
+	if p.opts != nil {
+		if p.opts.JQLQuery != "" {
+			query.Set("jql", p.opts.JQLQuery)
+		}
+		if p.opts.ValidateQuery {
+			query.Set("validateQuery", "true")
+		}
+		if len(p.opts.Fields) > 0 {
+			query.Set("fields", strings.Join(p.opts.Fields, ","))
+		}
+		if len(p.opts.Expand) > 0 {
+			query.Set("expand", strings.Join(p.opts.Expand, ","))
+		}
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s?%s", p.endpoint, query.Encode()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var page issuePageScheme
+	if _, err = p.client.call(request, &page); err != nil {
+		return nil, nil, err
+	}
+
+	return page.Issues, &PageMeta{
+		StartAt:    page.StartAt,
+		MaxResults: page.MaxResults,
+		Total:      page.Total,
+		IsLast:     page.IsLast,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package agile
+
+import "context"
+
+// PageMeta mirrors the startAt/maxResults/total/isLast pagination envelope
+// the Agile REST API attaches to every list response.
+type PageMeta struct {
+	StartAt    int
+	MaxResults int
+	Total      int
+	IsLast     bool
+}
+
+// PageFetcher retrieves a single page of T starting at startAt. Board,
+// Sprint and Epic list methods supply one of these to NewIterator so callers
+// don't have to write their own startAt/isLast loop.
+type PageFetcher[T any] func(ctx context.Context, startAt int) (values []T, page *PageMeta, err error)
+
+// Iterator walks every page a PageFetcher returns, one value at a time,
+// fetching the next page lazily and stopping once PageMeta.IsLast is true,
+// a page comes back empty, ctx is done, or fetch returns an error.
+type Iterator[T any] struct {
+	fetch PageFetcher[T]
+
+	values  []T
+	index   int
+	page    *PageMeta
+	started bool
+	err     error
+}
+
+// NewIterator builds an Iterator around fetch. Board/Sprint/Epic list
+// methods return one of these wrapped in their own pager type rather than
+// exposing NewIterator directly, so callers get Iter()/IterChan() without
+// needing to know the underlying endpoint shape.
+func NewIterator[T any](fetch PageFetcher[T]) *Iterator[T] {
+	return &Iterator[T]{fetch: fetch}
+}
+
+// Next advances to the next value, fetching additional pages as needed. It
+// returns false once every page has been consumed, ctx is cancelled, or
+// fetch returned an error — callers should check Err() to tell the two
+// apart.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+
+	if it.err != nil {
+		return false
+	}
+
+	if it.index < len(it.values) {
+		it.index++
+		return true
+	}
+
+	if it.started && it.page != nil && it.page.IsLast {
+		return false
+	}
+
+	select {
+	case <-ctx.Done():
+		it.err = ctx.Err()
+		return false
+	default:
+	}
+
+	startAt := 0
+	if it.page != nil {
+		startAt = it.page.StartAt + len(it.values)
+	}
+
+	values, page, err := it.fetch(ctx, startAt)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.started = true
+	it.values = values
+	it.page = page
+	it.index = 0
+
+	if len(values) == 0 {
+		return false
+	}
+
+	it.index = 1
+	return true
+}
+
+// Value returns the value Next just advanced to. It must only be called
+// after a call to Next that returned true.
+func (it *Iterator[T]) Value() T {
+	return it.values[it.index-1]
+}
+
+// Err returns the first error encountered while fetching pages, or the
+// ctx.Err() that stopped iteration early. Nil means iteration reached the
+// last page cleanly.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Page returns the pagination envelope of the most recently fetched page,
+// or nil before the first call to Next.
+func (it *Iterator[T]) Page() *PageMeta {
+	return it.page
+}
+
+// Result wraps a single value for IterChan's channel-based consumption.
+// Exactly one of Value or Err is meaningful for any given Result: Err is
+// only set on the final Result sent before the channel closes.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// IterChan drains the Iterator into a channel for pipeline-style
+// consumption, closing it once the iterator is exhausted, ctx is done, or
+// fetch produced an error (sent as the final Result before close).
+func (it *Iterator[T]) IterChan(ctx context.Context) <-chan Result[T] {
+
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for it.Next(ctx) {
+			select {
+			case out <- Result[T]{Value: it.Value()}:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			select {
+			case out <- Result[T]{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,168 @@
+package agile
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakePager builds a PageFetcher[int] over a fixed slice of pages, so the
+// Iterator's startAt/isLast bookkeeping can be exercised without a real
+// endpoint.
+func fakePager(pages [][]int) PageFetcher[int] {
+
+	return func(ctx context.Context, startAt int) ([]int, *PageMeta, error) {
+
+		index := 0
+		seen := 0
+		for index < len(pages) && seen != startAt {
+			seen += len(pages[index])
+			index++
+		}
+
+		if index >= len(pages) {
+			return nil, &PageMeta{StartAt: startAt, IsLast: true}, nil
+		}
+
+		values := pages[index]
+
+		return values, &PageMeta{
+			StartAt:    startAt,
+			MaxResults: len(values),
+			Total:      seen + len(values),
+			IsLast:     index == len(pages)-1,
+		}, nil
+	}
+}
+
+func TestIteratorWalksEveryPage(t *testing.T) {
+
+	it := NewIterator(fakePager([][]int{{1, 2}, {3, 4}, {5}}))
+
+	var got []int
+	for it.Next(context.Background()) {
+		got = append(got, it.Value())
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []int{1, 2, 3, 4, 5}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	if page := it.Page(); page == nil || !page.IsLast {
+		t.Fatalf("expected the final page to report IsLast")
+	}
+}
+
+func TestIteratorStopsOnEmptyPage(t *testing.T) {
+
+	fetch := func(ctx context.Context, startAt int) ([]int, *PageMeta, error) {
+		return nil, &PageMeta{StartAt: startAt, IsLast: false}, nil
+	}
+
+	it := NewIterator[int](fetch)
+
+	if it.Next(context.Background()) {
+		t.Fatalf("expected Next to return false on an empty first page even when IsLast is false")
+	}
+
+	if it.Err() != nil {
+		t.Fatalf("an empty page is not an error, got %v", it.Err())
+	}
+}
+
+func TestIteratorPropagatesFetchError(t *testing.T) {
+
+	boom := errors.New("boom")
+
+	fetch := func(ctx context.Context, startAt int) ([]int, *PageMeta, error) {
+		return nil, nil, boom
+	}
+
+	it := NewIterator[int](fetch)
+
+	if it.Next(context.Background()) {
+		t.Fatalf("expected Next to return false when the fetcher errors")
+	}
+
+	if !errors.Is(it.Err(), boom) {
+		t.Fatalf("got err %v, want %v", it.Err(), boom)
+	}
+}
+
+func TestIteratorStopsOnCancelledContext(t *testing.T) {
+
+	fetch := func(ctx context.Context, startAt int) ([]int, *PageMeta, error) {
+		return []int{1}, &PageMeta{StartAt: startAt, IsLast: false}, nil
+	}
+
+	it := NewIterator[int](fetch)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if !it.Next(ctx) {
+		t.Fatalf("expected the first page to be returned before cancellation")
+	}
+
+	cancel()
+
+	if it.Next(ctx) {
+		t.Fatalf("expected Next to stop once ctx is done")
+	}
+
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Fatalf("got err %v, want context.Canceled", it.Err())
+	}
+}
+
+func TestIteratorIterChanDrainsEveryValue(t *testing.T) {
+
+	it := NewIterator(fakePager([][]int{{1, 2}, {3}}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var got []int
+	for result := range it.IterChan(ctx) {
+		if result.Err != nil {
+			t.Fatalf("unexpected error from IterChan: %v", result.Err)
+		}
+		got = append(got, result.Value)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("got %v, want 3 values", got)
+	}
+}
+
+func TestIteratorIterChanSendsFetchError(t *testing.T) {
+
+	boom := errors.New("boom")
+
+	fetch := func(ctx context.Context, startAt int) ([]int, *PageMeta, error) {
+		return nil, nil, boom
+	}
+
+	it := NewIterator[int](fetch)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var lastErr error
+	for result := range it.IterChan(ctx) {
+		lastErr = result.Err
+	}
+
+	if !errors.Is(lastErr, boom) {
+		t.Fatalf("got final Result.Err = %v, want %v", lastErr, boom)
+	}
+}
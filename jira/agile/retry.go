@@ -0,0 +1,126 @@
+package agile
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how Client.call retries a request that fails with a
+// transient error (429/5xx or a network error) before the failure is
+// surfaced to the caller.
+type RetryPolicy struct {
+
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values <= 1 disable retries entirely.
+	MaxAttempts int
+
+	// BaseDelay and MaxDelay bound the exponential backoff applied between
+	// attempts when the response carries no Retry-After header. They default
+	// to 250ms and 10s respectively when left zero.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// ShouldRetry decides whether a given response/error pair is retryable.
+	// Defaults to retrying on 429, 5xx responses and network errors.
+	ShouldRetry func(response *http.Response, err error) bool
+}
+
+// noRetryPolicy preserves the pre-retry behavior of call: a single attempt,
+// no backoff.
+var noRetryPolicy = &RetryPolicy{MaxAttempts: 1}
+
+// DefaultRetryPolicy makes up to 3 attempts (the first plus 2 retries) on
+// 429/5xx responses and network errors, backing off exponentially from a
+// 250ms base delay up to a 10s cap.
+func DefaultRetryPolicy() *RetryPolicy {
+
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   250 * time.Millisecond,
+		MaxDelay:    10 * time.Second,
+		ShouldRetry: defaultShouldRetry,
+	}
+}
+
+func defaultShouldRetry(response *http.Response, err error) bool {
+
+	if err != nil {
+		return true
+	}
+
+	if response == nil {
+		return false
+	}
+
+	return response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500
+}
+
+func (p *RetryPolicy) shouldRetry(attempt int, response *http.Response, err error) bool {
+
+	if p == nil || attempt >= p.MaxAttempts-1 {
+		return false
+	}
+
+	predicate := p.ShouldRetry
+	if predicate == nil {
+		predicate = defaultShouldRetry
+	}
+
+	return predicate(response, err)
+}
+
+// nextDelay honours the Retry-After header (seconds or HTTP-date form) when
+// present, otherwise falls back to exponential backoff with jitter.
+func (p *RetryPolicy) nextDelay(attempt int, response *http.Response) time.Duration {
+
+	if response != nil {
+		if delay, ok := retryAfterDelay(response); ok {
+			return delay
+		}
+	}
+
+	base := p.BaseDelay
+	if base <= 0 {
+		base = 250 * time.Millisecond
+	}
+
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = 10 * time.Second
+	}
+
+	backoff := time.Duration(float64(base) * math.Pow(2, float64(attempt)))
+	if backoff > maxDelay {
+		backoff = maxDelay
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(backoff) + 1))
+
+	return backoff/2 + jitter/2
+}
+
+// retryAfterDelay parses the Retry-After header in both its seconds and
+// HTTP-date forms (RFC 7231 section 7.1.3).
+func retryAfterDelay(response *http.Response) (time.Duration, bool) {
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(when); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,168 @@
+package agile
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryAfterDelaySeconds(t *testing.T) {
+
+	response := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+
+	delay, ok := retryAfterDelay(response)
+	if !ok {
+		t.Fatalf("expected retryAfterDelay to recognise a seconds-form Retry-After header")
+	}
+
+	if delay != 2*time.Second {
+		t.Fatalf("got delay %v, want 2s", delay)
+	}
+}
+
+func TestRetryAfterDelayHTTPDate(t *testing.T) {
+
+	when := time.Now().Add(3 * time.Second).UTC()
+	response := &http.Response{Header: http.Header{"Retry-After": []string{when.Format(http.TimeFormat)}}}
+
+	delay, ok := retryAfterDelay(response)
+	if !ok {
+		t.Fatalf("expected retryAfterDelay to recognise an HTTP-date Retry-After header")
+	}
+
+	if delay <= 0 || delay > 3*time.Second {
+		t.Fatalf("got delay %v, want something in (0, 3s]", delay)
+	}
+}
+
+func TestRetryAfterDelayMissing(t *testing.T) {
+
+	response := &http.Response{Header: http.Header{}}
+
+	if _, ok := retryAfterDelay(response); ok {
+		t.Fatalf("expected retryAfterDelay to report no delay when the header is absent")
+	}
+}
+
+func TestDefaultShouldRetry(t *testing.T) {
+
+	cases := map[string]struct {
+		response *http.Response
+		err      error
+		want     bool
+	}{
+		"network error":      {response: nil, err: context.DeadlineExceeded, want: true},
+		"429":                {response: &http.Response{StatusCode: http.StatusTooManyRequests}, want: true},
+		"503":                {response: &http.Response{StatusCode: http.StatusServiceUnavailable}, want: true},
+		"200 is not retried": {response: &http.Response{StatusCode: http.StatusOK}, want: false},
+		"404 is not retried": {response: &http.Response{StatusCode: http.StatusNotFound}, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := defaultShouldRetry(tc.response, tc.err); got != tc.want {
+				t.Fatalf("defaultShouldRetry() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyShouldRetryRespectsMaxAttempts(t *testing.T) {
+
+	policy := &RetryPolicy{MaxAttempts: 2, ShouldRetry: func(*http.Response, error) bool { return true }}
+
+	if !policy.shouldRetry(0, nil, nil) {
+		t.Fatalf("expected attempt 0 of 2 to be retryable")
+	}
+
+	if policy.shouldRetry(1, nil, nil) {
+		t.Fatalf("attempt 1 of 2 is the last attempt and must not be retried")
+	}
+}
+
+// TestClientCallRetriesTransientFailures drives Client.call against a
+// server that fails with 503 twice before succeeding, and asserts that the
+// retry loop eventually surfaces the successful response after exactly three
+// attempts.
+func TestClientCallRetriesTransientFailures(t *testing.T) {
+
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if atomic.AddInt32(&attempts, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"errorMessages":["try again"]}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTP: server.Client(),
+		Auth: &AuthenticationService{},
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts: 3,
+			BaseDelay:   time.Millisecond,
+			MaxDelay:    5 * time.Millisecond,
+			ShouldRetry: defaultShouldRetry,
+		},
+	}
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("building request: %v", err)
+	}
+
+	result, err := client.call(request, nil)
+	if err != nil {
+		t.Fatalf("call() returned an error after the policy should have retried past the transient failures: %v", err)
+	}
+
+	if result.Code != http.StatusOK {
+		t.Fatalf("got status %d, want 200", result.Code)
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Fatalf("got %d attempts, want 3 (2 failures + 1 success)", got)
+	}
+}
+
+// TestClientCallStopsRetryingOnNonTransientStatus asserts a 404 is surfaced
+// immediately as an error rather than being retried MaxAttempts times.
+func TestClientCallStopsRetryingOnNonTransientStatus(t *testing.T) {
+
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errorMessages":["not found"]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTP:        server.Client(),
+		Auth:        &AuthenticationService{},
+		RetryPolicy: DefaultRetryPolicy(),
+	}
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("building request: %v", err)
+	}
+
+	if _, err = client.call(request, nil); err == nil {
+		t.Fatalf("expected a 404 to surface as an error")
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Fatalf("got %d attempts, want 1 (404 is not retryable)", got)
+	}
+}
@@ -0,0 +1,48 @@
+package agile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+)
+
+// MoveIssuesAsync moves issues onto the sprint without blocking until the
+// move finishes. Jira Agile accepts the request with a 202 and performs the
+// move in the background; the returned Future lets the caller decide how
+// (or whether) to wait for completion.
+//
+// The initial POST goes through Client.call, same as every other request in
+// the package, so it gets the configured RetryPolicy (a transient 429/503 on
+// the kickoff request is retried, not just the polls that follow it).
+//
+// https://docs.atlassian.com/jira-software/REST/latest/#agile/1.0/sprint-moveIssuesToSprint
+func (s *SprintService) MoveIssuesAsync(ctx context.Context, sprintID int, payload *models.SprintMovePayloadScheme) (*Future, error) {
+
+	endpoint := fmt.Sprintf("rest/agile/1.0/sprint/%v/issue", sprintID)
+
+	reader, err := s.client.transformStructToReader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	_, err = s.client.call(request, nil)
+
+	var accepted *AsyncAccepted
+	if !errors.As(err, &accepted) {
+		if err == nil {
+			err = fmt.Errorf("agile: expected 202 Accepted, got a synchronous response")
+		}
+		return nil, err
+	}
+
+	return newFuture(s.client, accepted.Location), nil
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors that AgileError wraps so callers can branch on the failure
+// class with errors.Is/errors.As instead of string-matching status codes.
+var (
+	ErrUnauthorized = fmt.Errorf("agile: unauthorized")
+	ErrNotFound     = fmt.Errorf("agile: resource not found")
+	ErrRateLimited  = fmt.Errorf("agile: rate limited")
+	ErrConflict     = fmt.Errorf("agile: conflict")
+	ErrValidation   = fmt.Errorf("agile: validation failed")
+)
+
+// AgileError represents a non-2xx response returned by the Jira Agile REST
+// API. It captures the decoded error payload (errorMessages/errors/warnings)
+// alongside the request metadata, and preserves the raw body so callers can
+// fall back to it when the payload doesn't decode cleanly.
+type AgileError struct {
+	StatusCode    int               `json:"-"`
+	Endpoint      string            `json:"-"`
+	Method        string            `json:"-"`
+	ErrorMessages []string          `json:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty"`
+	Warnings      []string          `json:"warnings,omitempty"`
+	Body          []byte            `json:"-"`
+}
+
+func (e *AgileError) Error() string {
+
+	if len(e.ErrorMessages) > 0 {
+		return fmt.Sprintf("agile: %s %s: %d %s", e.Method, e.Endpoint, e.StatusCode, strings.Join(e.ErrorMessages, "; "))
+	}
+
+	return fmt.Sprintf("agile: %s %s: %d", e.Method, e.Endpoint, e.StatusCode)
+}
+
+// Unwrap lets callers use errors.Is(err, models.ErrNotFound) and friends to
+// branch on the failure class instead of inspecting StatusCode directly.
+func (e *AgileError) Unwrap() error {
+
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	default:
+		return nil
+	}
+}
+
+// NewAgileError builds an AgileError for the given request/response
+// metadata, decoding body into the ErrorMessages/Errors/Warnings fields on a
+// best-effort basis. The raw body is always preserved, even when it isn't
+// valid JSON.
+func NewAgileError(statusCode int, method, endpoint string, body []byte) *AgileError {
+
+	agileErr := &AgileError{
+		StatusCode: statusCode,
+		Endpoint:   endpoint,
+		Method:     method,
+		Body:       body,
+	}
+
+	_ = json.Unmarshal(body, agileErr)
+
+	return agileErr
+}
@@ -0,0 +1,101 @@
+package models
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAgileErrorUnwrap(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		statusCode int
+		want       error
+	}{
+		{"unauthorized", http.StatusUnauthorized, ErrUnauthorized},
+		{"forbidden", http.StatusForbidden, ErrUnauthorized},
+		{"not found", http.StatusNotFound, ErrNotFound},
+		{"rate limited", http.StatusTooManyRequests, ErrRateLimited},
+		{"conflict", http.StatusConflict, ErrConflict},
+		{"bad request", http.StatusBadRequest, ErrValidation},
+		{"unprocessable entity", http.StatusUnprocessableEntity, ErrValidation},
+		{"unmapped status", http.StatusInternalServerError, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			agileErr := &AgileError{StatusCode: tt.statusCode}
+
+			if got := agileErr.Unwrap(); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+
+			if tt.want != nil && !errors.Is(agileErr, tt.want) {
+				t.Fatalf("expected errors.Is(err, %v) to hold", tt.want)
+			}
+		})
+	}
+}
+
+func TestAgileErrorErrorMessage(t *testing.T) {
+
+	withoutMessages := &AgileError{StatusCode: http.StatusNotFound, Method: http.MethodGet, Endpoint: "/board/1"}
+	if got, want := withoutMessages.Error(), "agile: GET /board/1: 404"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	withMessages := &AgileError{
+		StatusCode:    http.StatusBadRequest,
+		Method:        http.MethodPost,
+		Endpoint:      "/board",
+		ErrorMessages: []string{"name is required", "type is invalid"},
+	}
+	want := "agile: POST /board: 400 name is required; type is invalid"
+	if got := withMessages.Error(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestNewAgileErrorDecodesBody(t *testing.T) {
+
+	body := []byte(`{"errorMessages":["bad input"],"errors":{"name":"required"},"warnings":["deprecated"]}`)
+
+	agileErr := NewAgileError(http.StatusBadRequest, http.MethodPost, "/board", body)
+
+	if len(agileErr.ErrorMessages) != 1 || agileErr.ErrorMessages[0] != "bad input" {
+		t.Fatalf("got ErrorMessages %+v, want [bad input]", agileErr.ErrorMessages)
+	}
+
+	if agileErr.Errors["name"] != "required" {
+		t.Fatalf("got Errors %+v, want name:required", agileErr.Errors)
+	}
+
+	if len(agileErr.Warnings) != 1 || agileErr.Warnings[0] != "deprecated" {
+		t.Fatalf("got Warnings %+v, want [deprecated]", agileErr.Warnings)
+	}
+
+	if string(agileErr.Body) != string(body) {
+		t.Fatalf("got Body %q, want raw body preserved", agileErr.Body)
+	}
+}
+
+func TestNewAgileErrorToleratesMalformedBody(t *testing.T) {
+
+	body := []byte("not json")
+
+	agileErr := NewAgileError(http.StatusInternalServerError, http.MethodGet, "/board/1", body)
+
+	if agileErr.StatusCode != http.StatusInternalServerError || agileErr.Method != http.MethodGet || agileErr.Endpoint != "/board/1" {
+		t.Fatalf("got %+v, want request metadata preserved despite decode failure", agileErr)
+	}
+
+	if string(agileErr.Body) != string(body) {
+		t.Fatalf("got Body %q, want raw body preserved even when it isn't JSON", agileErr.Body)
+	}
+
+	if len(agileErr.ErrorMessages) != 0 || len(agileErr.Errors) != 0 || len(agileErr.Warnings) != 0 {
+		t.Fatalf("got %+v, want no decoded fields when the body isn't valid JSON", agileErr)
+	}
+}